@@ -0,0 +1,180 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+// Does marshaling after N draws and unmarshaling into a fresh PCG32
+// reproduce identical subsequent output?
+func TestPCG32BinaryRoundTrip(t *testing.T) {
+	source := NewPCG32().Seed(1, 1)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	data, err := source.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := NewPCG32()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+// Does marshaling after N draws and unmarshaling into a fresh PCG64
+// reproduce identical subsequent output?
+func TestPCG64BinaryRoundTrip(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	data, err := source.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := NewPCG64()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+// Does marshaling after N draws and unmarshaling into a fresh
+// PCG128DXSM reproduce identical subsequent output?
+func TestPCG128DXSMBinaryRoundTrip(t *testing.T) {
+	source := NewPCG128DXSM().Seed(0, 1, 0, 1)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	data, err := source.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := NewPCG128DXSM()
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+// Does unmarshaling reject a mismatched variant tag?
+func TestUnmarshalBinaryRejectsWrongVariant(t *testing.T) {
+	data, _ := NewPCG64().Seed(1, 1, 1, 2).MarshalBinary()
+	if err := NewPCG32().UnmarshalBinary(data); err == nil {
+		t.Errorf("PCG32.UnmarshalBinary accepted a PCG64 encoding")
+	}
+
+	// PCG64 and PCG128DXSM encodings have the same length, so only the
+	// variant tag tells them apart.
+	data, _ = NewPCG128DXSM().Seed(0, 1, 0, 1).MarshalBinary()
+	if err := NewPCG64().UnmarshalBinary(data); err == nil {
+		t.Errorf("PCG64.UnmarshalBinary accepted a PCG128DXSM encoding")
+	}
+}
+
+// Does GobEncode/GobDecode round-trip a PCG32 through encoding/gob?
+func TestPCG32GobRoundTrip(t *testing.T) {
+	source := NewPCG32().Seed(1, 1)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(source); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+
+	restored := NewPCG32()
+	if err := gob.NewDecoder(&buf).Decode(restored); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+// Does GobEncode/GobDecode round-trip a PCG64 through encoding/gob?
+func TestPCG64GobRoundTrip(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(source); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+
+	restored := NewPCG64()
+	if err := gob.NewDecoder(&buf).Decode(restored); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+// Does GobEncode/GobDecode round-trip a PCG128DXSM through encoding/gob?
+func TestPCG128DXSMGobRoundTrip(t *testing.T) {
+	source := NewPCG128DXSM().Seed(0, 1, 0, 1)
+	for i := 0; i < 37; i++ {
+		source.Random()
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(source); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+
+	restored := NewPCG128DXSM()
+	if err := gob.NewDecoder(&buf).Decode(restored); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+
+	for i := 0; i < 256; i++ {
+		if want, got := source.Random(), restored.Random(); want != got {
+			t.Fatalf("#%d: want %d, got %d", i, want, got)
+		}
+	}
+}
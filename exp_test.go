@@ -0,0 +1,58 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"math"
+	"testing"
+)
+
+// Does ExpFloat64 reproduce the same stream for the same seed?
+func TestExpFloat64Reproducible(t *testing.T) {
+	a := NewPCG64().Seed(1, 1, 1, 2)
+	b := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 1000; i++ {
+		if x, y := a.ExpFloat64(), b.ExpFloat64(); x != y {
+			t.Fatalf("#%d: %g != %g", i, x, y)
+		}
+	}
+}
+
+// Does ExpFloat64 stay positive and average close to 1/lambda (lambda = 1)?
+func TestExpFloat64Statistics(t *testing.T) {
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	const n = 200000
+
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		x := pcg.ExpFloat64()
+		if x <= 0 {
+			t.Fatalf("#%d: ExpFloat64() = %g; want > 0", i, x)
+		}
+		sum += x
+	}
+	mean := sum / n
+	if math.Abs(mean-1) > 0.02 {
+		t.Errorf("mean = %g; want close to 1", mean)
+	}
+}
+
+// Measure the time it takes to generate exponentially distributed values
+func BenchmarkExpFloat64(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.ExpFloat64()
+	}
+}
@@ -0,0 +1,45 @@
+package pcg
+
+// PCG Random Number Generation
+// Developed by Melissa O'Neill <oneill@pcg-random.org>
+// Paper and details at http://www.pcg-random.org
+// Ported to Go by Michael Jones <michael.jones@gmail.com>
+
+// Gamma distribution, using Marsaglia & Tsang's squeeze method ("A Simple
+// Method for Generating Gamma Variables", 2000), layered on NormFloat64 and
+// float64 so reproducibility from a seed is preserved.
+
+import "math"
+
+// Gamma returns a Gamma-distributed float64 with the given shape (k) and
+// scale (theta) parameters. shape must be positive.
+func (p *PCG64) Gamma(shape, scale float64) float64 {
+	if shape < 1 {
+		// Boost sub-1 shapes into the shape>=1 case Marsaglia & Tsang
+		// handles directly, then correct with a single extra uniform draw.
+		u := p.float64()
+		return p.Gamma(shape+1, scale) * math.Pow(u, 1/shape)
+	}
+
+	d := shape - 1.0/3.0
+	c := 1.0 / math.Sqrt(9*d)
+	for {
+		var x, v float64
+		for {
+			x = p.NormFloat64()
+			v = 1 + c*x
+			if v > 0 {
+				break
+			}
+		}
+		v = v * v * v
+		u := p.float64()
+
+		if u < 1-0.0331*x*x*x*x {
+			return d * v * scale
+		}
+		if math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v * scale
+		}
+	}
+}
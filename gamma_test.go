@@ -0,0 +1,69 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"math"
+	"testing"
+)
+
+// Is the sample mean of a Gamma(shape, scale) draw close to shape*scale,
+// both above and below shape = 1 (the boosted case)?
+func TestGammaMean(t *testing.T) {
+	cases := []struct{ shape, scale float64 }{
+		{0.5, 2.0},
+		{1.0, 3.0},
+		{2.0, 1.5},
+		{9.0, 1.0},
+	}
+
+	for _, c := range cases {
+		pcg := NewPCG64().Seed(1, 1, 1, 2)
+		const n = 200000
+
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			x := pcg.Gamma(c.shape, c.scale)
+			if x < 0 {
+				t.Fatalf("shape=%g scale=%g: Gamma() = %g; want >= 0", c.shape, c.scale, x)
+			}
+			sum += x
+		}
+		mean := sum / n
+		want := c.shape * c.scale
+		if math.Abs(mean-want) > 0.05*want+0.05 {
+			t.Errorf("shape=%g scale=%g: mean = %g; want close to %g", c.shape, c.scale, mean, want)
+		}
+	}
+}
+
+// Does Gamma reproduce the same stream for the same seed?
+func TestGammaReproducible(t *testing.T) {
+	a := NewPCG64().Seed(1, 1, 1, 2)
+	b := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 1000; i++ {
+		if x, y := a.Gamma(2, 1), b.Gamma(2, 1); x != y {
+			t.Fatalf("#%d: %g != %g", i, x, y)
+		}
+	}
+}
+
+// Measure the time it takes to generate Gamma-distributed values
+func BenchmarkGamma(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.Gamma(2, 1)
+	}
+}
@@ -0,0 +1,62 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"math"
+	"testing"
+)
+
+// Does NormFloat64 reproduce the same stream for the same seed?
+func TestNormFloat64Reproducible(t *testing.T) {
+	a := NewPCG64().Seed(1, 1, 1, 2)
+	b := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 1000; i++ {
+		if x, y := a.NormFloat64(), b.NormFloat64(); x != y {
+			t.Fatalf("#%d: %g != %g", i, x, y)
+		}
+	}
+}
+
+// Is the sample mean and standard deviation of a large draw close to the
+// standard normal's (0, 1)?
+func TestNormFloat64Statistics(t *testing.T) {
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	const n = 200000
+
+	sum, sumSq := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		x := pcg.NormFloat64()
+		sum += x
+		sumSq += x * x
+	}
+	mean := sum / n
+	variance := sumSq/n - mean*mean
+
+	if math.Abs(mean) > 0.02 {
+		t.Errorf("mean = %g; want close to 0", mean)
+	}
+	if math.Abs(variance-1) > 0.05 {
+		t.Errorf("variance = %g; want close to 1", variance)
+	}
+}
+
+// Measure the time it takes to generate normally distributed values
+func BenchmarkNormFloat64(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.NormFloat64()
+	}
+}
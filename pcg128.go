@@ -0,0 +1,137 @@
+package pcg
+
+import "math/bits"
+
+// PCG128DXSM is a 128-bit-state PCG with the DXSM ("double xorshift
+// multiply") output function, the same generator math/rand/v2 and NumPy
+// use as their default PCG64. Unlike PCG64, which composes two independent
+// PCG32 streams, PCG128DXSM carries one genuine 128-bit LCG state, giving
+// it a 2^128 period per stream and removing the cross-stream correlation
+// that composition can introduce.
+
+const (
+	mul128Hi uint64 = 2549297995355413924
+	mul128Lo uint64 = 4865540595714422341
+	cheapMul uint64 = 0xda942042e4dd58b5
+)
+
+type PCG128DXSM struct {
+	stateHi, stateLo uint64
+	incHi, incLo     uint64
+}
+
+func NewPCG128DXSM() *PCG128DXSM {
+	return &PCG128DXSM{}
+}
+
+// Seed initializes the generator from a 128-bit state (stateHi:stateLo) and
+// a 128-bit stream selector (seqHi:seqLo), following the usual PCG
+// initialization: set the increment from the (odd-ified) sequence, step
+// once, add in the state, then step again.
+func (p *PCG128DXSM) Seed(stateHi, stateLo, seqHi, seqLo uint64) *PCG128DXSM {
+	p.stateHi, p.stateLo = 0, 0
+	p.incHi = (seqHi << 1) | (seqLo >> 63)
+	p.incLo = (seqLo << 1) | 1
+	p.step()
+	p.stateHi, p.stateLo = add128(p.stateHi, p.stateLo, stateHi, stateLo)
+	p.step()
+	return p
+}
+
+func (p *PCG128DXSM) step() {
+	p.stateHi, p.stateLo = mul128(p.stateHi, p.stateLo, mul128Hi, mul128Lo)
+	p.stateHi, p.stateLo = add128(p.stateHi, p.stateLo, p.incHi, p.incLo)
+}
+
+// Random returns the next 64-bit output, advancing the 128-bit LCG and
+// applying the DXSM permutation to the resulting state.
+func (p *PCG128DXSM) Random() uint64 {
+	p.step()
+	hi, lo := p.stateHi, p.stateLo
+	hi ^= hi >> 32
+	hi *= cheapMul
+	hi ^= hi >> 48
+	hi *= lo | 1
+	return hi
+}
+
+func (p *PCG128DXSM) Bounded(bound uint64) uint64 {
+	if bound == 0 {
+		return 0
+	}
+	threshold := -bound % bound
+	for {
+		r := p.Random()
+		if r >= threshold {
+			return r % bound
+		}
+	}
+}
+
+// Advance jumps the generator ahead by delta steps in O(log delta) time via
+// the standard LCG square-and-multiply recurrence, generalized to the
+// 128-bit multiplier and increment.
+func (p *PCG128DXSM) Advance(delta uint64) *PCG128DXSM {
+	return p.advanceBy(0, delta)
+}
+
+// Retreat jumps the generator back by delta steps. Unlike PCG32 and PCG64,
+// whose 2^64 period lets "advance by -delta mod 2^64" double as "retreat by
+// delta", PCG128DXSM has a 2^128 period, so retreating requires negating
+// delta as a 128-bit quantity before handing it to advanceBy.
+func (p *PCG128DXSM) Retreat(delta uint64) *PCG128DXSM {
+	hi, lo := negate128(0, delta)
+	return p.advanceBy(hi, lo)
+}
+
+// advanceBy runs the square-and-multiply jump-ahead for a full 128-bit step
+// count (deltaHi:deltaLo).
+func (p *PCG128DXSM) advanceBy(deltaHi, deltaLo uint64) *PCG128DXSM {
+	accMulHi, accMulLo := uint64(0), uint64(1)
+	accIncHi, accIncLo := uint64(0), uint64(0)
+	curMulHi, curMulLo := mul128Hi, mul128Lo
+	curIncHi, curIncLo := p.incHi, p.incLo
+
+	for dHi, dLo := deltaHi, deltaLo; dHi != 0 || dLo != 0; dHi, dLo = shr128(dHi, dLo) {
+		if dLo&1 != 0 {
+			accMulHi, accMulLo = mul128(accMulHi, accMulLo, curMulHi, curMulLo)
+			hi, lo := mul128(accIncHi, accIncLo, curMulHi, curMulLo)
+			accIncHi, accIncLo = add128(hi, lo, curIncHi, curIncLo)
+		}
+		mp1Hi, mp1Lo := add128(curMulHi, curMulLo, 0, 1)
+		curIncHi, curIncLo = mul128(mp1Hi, mp1Lo, curIncHi, curIncLo)
+		curMulHi, curMulLo = mul128(curMulHi, curMulLo, curMulHi, curMulLo)
+	}
+
+	p.stateHi, p.stateLo = mul128(accMulHi, accMulLo, p.stateHi, p.stateLo)
+	p.stateHi, p.stateLo = add128(p.stateHi, p.stateLo, accIncHi, accIncLo)
+	return p
+}
+
+// shr128 shifts the 128-bit value (hi:lo) right by one bit.
+func shr128(hi, lo uint64) (uint64, uint64) {
+	return hi >> 1, (lo >> 1) | (hi << 63)
+}
+
+// negate128 returns the two's complement negation of (hi:lo) mod 2^128.
+func negate128(hi, lo uint64) (uint64, uint64) {
+	lo, carry := bits.Add64(^lo, 1, 0)
+	hi, _ = bits.Add64(^hi, 0, carry)
+	return hi, lo
+}
+
+// mul128 returns the low 128 bits of the exact product of the two 128-bit
+// values (aHi:aLo) and (bHi:bLo), which is all that a 128-bit LCG needs.
+func mul128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	hi, lo = bits.Mul64(aLo, bLo)
+	hi += aLo*bHi + aHi*bLo
+	return hi, lo
+}
+
+// add128 returns (aHi:aLo) + (bHi:bLo) mod 2^128.
+func add128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	var carry uint64
+	lo, carry = bits.Add64(aLo, bLo, 0)
+	hi, _ = bits.Add64(aHi, bHi, carry)
+	return hi, lo
+}
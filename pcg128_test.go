@@ -0,0 +1,99 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import "testing"
+
+// Basic sanity test: is first known value determined properly?
+func TestSanity128(t *testing.T) {
+	result := NewPCG128DXSM().Seed(0, 1, 0, 1).Random()
+	expect := uint64(4845935794696825393)
+	if result != expect {
+		t.Errorf("NewPCG128DXSM().Seed(0, 1, 0, 1).Random() is %d; want %d", result, expect)
+	}
+}
+
+var sumTests128 = []struct {
+	stateHi, stateLo uint64
+	seqHi, seqLo     uint64
+	count            int
+	sum              uint64
+}{
+	{0, 1, 0, 1, 10, 1721236712184738317},
+	{0, 1, 0, 1, 100, 3144513931095288526},
+	{0, 1, 0, 1, 1000, 8484851295391551993},
+	{0, 1, 0, 1, 10000, 10336141246504711441},
+}
+
+// Are the sums of the first few values consistent with expectation?
+func TestSum128(t *testing.T) {
+	for i, a := range sumTests128 {
+		pcg := NewPCG128DXSM().Seed(a.stateHi, a.stateLo, a.seqHi, a.seqLo)
+		sum := uint64(0)
+		for j := 0; j < a.count; j++ {
+			sum += pcg.Random()
+		}
+		if sum != a.sum {
+			t.Errorf("#%d, sum of first %d values = %d; want %d", i, a.count, sum, a.sum)
+		}
+	}
+}
+
+const count128 = 256
+
+// Does advancing work?
+func TestAdvance128(t *testing.T) {
+	pcg := NewPCG128DXSM().Seed(0, 1, 0, 1)
+	values := make([]uint64, count128)
+	for i := range values {
+		values[i] = pcg.Random()
+	}
+
+	for skip := 1; skip < count128; skip++ {
+		pcg.Seed(0, 1, 0, 1)
+		pcg.Advance(uint64(skip))
+		result := pcg.Random()
+		expect := values[skip]
+		if result != expect {
+			t.Errorf("Advance(%d) is %d; want %d", skip, result, expect)
+		}
+	}
+}
+
+// Does retreating work?
+func TestRetreat128(t *testing.T) {
+	pcg := NewPCG128DXSM().Seed(0, 1, 0, 1)
+	expect := pcg.Random()
+
+	for skip := 1; skip < count128; skip++ {
+		pcg.Seed(0, 1, 0, 1)
+		for i := 0; i < skip; i++ {
+			_ = pcg.Random()
+		}
+		pcg.Retreat(uint64(skip))
+		result := pcg.Random()
+		if result != expect {
+			t.Errorf("Retreat(%d) is %d; want %d", skip, result, expect)
+		}
+	}
+}
+
+// Measure the time it takes to generate random values
+func BenchmarkRandom128(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG128DXSM().Seed(0, 1, 0, 1)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.Random()
+	}
+}
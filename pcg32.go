@@ -0,0 +1,79 @@
+package pcg
+
+// PCG Random Number Generation
+// Developed by Melissa O'Neill <oneill@pcg-random.org>
+// Paper and details at http://www.pcg-random.org
+// Ported to Go by Michael Jones <michael.jones@gmail.com>
+
+const mul32 uint64 = 6364136223846793005
+
+type PCG32 struct {
+	state uint64
+	inc   uint64
+}
+
+func NewPCG32() *PCG32 {
+	return &PCG32{}
+}
+
+func (p *PCG32) Seed(state, sequence uint64) *PCG32 {
+	p.state = 0
+	p.inc = (sequence << 1) | 1
+	p.step()
+	p.state += state
+	p.step()
+	return p
+}
+
+func (p *PCG32) step() {
+	p.state = p.state*mul32 + p.inc
+}
+
+func (p *PCG32) Random() uint32 {
+	old := p.state
+	p.step()
+	xorshifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := uint32(old >> 59)
+	return (xorshifted >> rot) | (xorshifted << ((-rot) & 31))
+}
+
+func (p *PCG32) Bounded(bound uint32) uint32 {
+	if bound == 0 {
+		return 0
+	}
+	threshold := -bound % bound
+	for {
+		r := p.Random()
+		if r >= threshold {
+			return r % bound
+		}
+	}
+}
+
+func (p *PCG32) Advance(delta uint64) *PCG32 {
+	p.state = lcg64Advance(p.state, delta, mul32, p.inc)
+	return p
+}
+
+func (p *PCG32) Retreat(delta uint64) *PCG32 {
+	return p.Advance(-delta)
+}
+
+// lcg64Advance computes the state that delta steps of the 64-bit LCG
+// state = state*mul + inc would produce, using the O'Neill doubling trick
+// (square-and-multiply over the affine transform) so that the jump runs in
+// O(log delta) time rather than O(delta).
+func lcg64Advance(state, delta, mul, inc uint64) uint64 {
+	accMul := uint64(1)
+	accInc := uint64(0)
+	for delta > 0 {
+		if delta&1 != 0 {
+			accMul *= mul
+			accInc = accInc*mul + inc
+		}
+		inc = (mul + 1) * inc
+		mul *= mul
+		delta >>= 1
+	}
+	return accMul*state + accInc
+}
@@ -50,3 +50,18 @@ func (p *PCG64) Advance(delta uint64) *PCG64 {
 func (p *PCG64) Retreat(delta uint64) *PCG64 {
 	return p.Advance(-delta)
 }
+
+// uint32 returns the high 32 bits of the next 64-bit output. The Ziggurat
+// samplers in normal.go and exp.go use it wherever math/rand's tables
+// expect a uint32 draw.
+func (p *PCG64) uint32() uint32 {
+	return uint32(p.Random() >> 32)
+}
+
+// float64 returns a pseudo-random float64 in the half-open interval
+// [0, 1), with the same 53 bits of precision math/rand's Float64 uses. The
+// distribution samplers in normal.go, exp.go, zipf.go, poisson.go, and
+// gamma.go build on it.
+func (p *PCG64) float64() float64 {
+	return float64(p.Random()>>11) / (1 << 53)
+}
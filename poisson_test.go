@@ -0,0 +1,72 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"math"
+	"testing"
+)
+
+func testPoissonMean(t *testing.T, lambda float64) {
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	const n = 100000
+
+	sum := uint64(0)
+	for i := 0; i < n; i++ {
+		sum += pcg.Poisson(lambda)
+	}
+	mean := float64(sum) / n
+	if tolerance := 0.05 * lambda; math.Abs(mean-lambda) > tolerance+0.05 {
+		t.Errorf("lambda = %g: mean = %g; want close to %g", lambda, mean, lambda)
+	}
+}
+
+// Is the sample mean close to lambda, both below and above the Knuth/PTRS
+// cutoff?
+func TestPoissonMean(t *testing.T) {
+	for _, lambda := range []float64{1, 4, 9.9, 10, 25, 100} {
+		testPoissonMean(t, lambda)
+	}
+}
+
+// Does Poisson reproduce the same stream for the same seed?
+func TestPoissonReproducible(t *testing.T) {
+	a := NewPCG64().Seed(1, 1, 1, 2)
+	b := NewPCG64().Seed(1, 1, 1, 2)
+	for i := 0; i < 1000; i++ {
+		if x, y := a.Poisson(12), b.Poisson(12); x != y {
+			t.Fatalf("#%d: %d != %d", i, x, y)
+		}
+	}
+}
+
+// Measure the time it takes to draw a small-lambda (Knuth) Poisson value
+func BenchmarkPoissonSmall(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.Poisson(4)
+	}
+}
+
+// Measure the time it takes to draw a large-lambda (PTRS) Poisson value
+func BenchmarkPoissonLarge(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = pcg.Poisson(100)
+	}
+}
@@ -0,0 +1,34 @@
+package pcg
+
+// PCG Random Number Generation
+// Developed by Melissa O'Neill <oneill@pcg-random.org>
+// Paper and details at http://www.pcg-random.org
+// Ported to Go by Michael Jones <michael.jones@gmail.com>
+
+import "encoding/binary"
+
+// Read implements io.Reader, filling b with pseudo-random bytes. It always
+// fills b completely and never returns an error, matching the convention
+// math/rand/v2.Rand.Read uses.
+func (p *PCG64) Read(b []byte) (int, error) {
+	p.Fill(b)
+	return len(b), nil
+}
+
+// Fill writes pseudo-random bytes into b, drawing one Random() per 8 bytes
+// rather than one per byte. A remainder of 1 to 7 bytes takes the low bytes
+// of one further Random(), written by a per-byte loop; that tail is not
+// unrolled.
+func (p *PCG64) Fill(b []byte) {
+	for len(b) >= 8 {
+		binary.LittleEndian.PutUint64(b, p.Random())
+		b = b[8:]
+	}
+	if len(b) > 0 {
+		v := p.Random()
+		for i := range b {
+			b[i] = byte(v)
+			v >>= 8
+		}
+	}
+}
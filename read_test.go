@@ -0,0 +1,109 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"io"
+	"testing"
+)
+
+// Does Read satisfy io.Reader, always filling b and never erroring?
+func TestReadFillsCompletely(t *testing.T) {
+	var _ io.Reader = NewPCG64()
+
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	for _, n := range []int{0, 1, 7, 8, 9, 16, 100, 257} {
+		b := make([]byte, n)
+		got, err := pcg.Read(b)
+		if err != nil {
+			t.Fatalf("n=%d: Read returned error %v", n, err)
+		}
+		if got != n {
+			t.Fatalf("n=%d: Read returned %d, want %d", n, got, n)
+		}
+	}
+}
+
+// Does Read produce the same bytes Fill would, for the same seed and
+// request size?
+func TestReadMatchesFill(t *testing.T) {
+	const n = 271
+	viaRead := make([]byte, n)
+	viaFill := make([]byte, n)
+
+	NewPCG64().Seed(1, 1, 1, 2).Fill(viaFill)
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	pcg.Read(viaRead)
+
+	for i := range viaRead {
+		if viaRead[i] != viaFill[i] {
+			t.Fatalf("byte %d: Read = %#x, Fill = %#x", i, viaRead[i], viaFill[i])
+		}
+	}
+}
+
+// Does Fill match drawing Random() directly and decoding little-endian?
+func TestFillMatchesRandom(t *testing.T) {
+	direct := NewPCG64().Seed(1, 1, 1, 2)
+	filled := NewPCG64().Seed(1, 1, 1, 2)
+
+	b := make([]byte, 8*10)
+	filled.Fill(b)
+
+	for i := 0; i < 10; i++ {
+		want := direct.Random()
+		got := uint64(b[i*8]) | uint64(b[i*8+1])<<8 | uint64(b[i*8+2])<<16 | uint64(b[i*8+3])<<24 |
+			uint64(b[i*8+4])<<32 | uint64(b[i*8+5])<<40 | uint64(b[i*8+6])<<48 | uint64(b[i*8+7])<<56
+		if want != got {
+			t.Fatalf("word %d: want %d, got %d", i, want, got)
+		}
+	}
+}
+
+const benchmarkFillSize = 4096
+
+// Measure the time it takes to fill a buffer with the bulk Read path
+func BenchmarkRead(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	buf := make([]byte, benchmarkFillSize)
+	b.SetBytes(benchmarkFillSize)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		pcg.Read(buf)
+	}
+}
+
+// Measure the time it takes to fill a buffer one byte at a time, to
+// justify Fill's unrolled bulk path.
+func BenchmarkReadNaive(b *testing.B) {
+	b.StopTimer()
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	buf := make([]byte, benchmarkFillSize)
+	b.SetBytes(benchmarkFillSize)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		v := uint64(0)
+		bits := 0
+		for j := range buf {
+			if bits == 0 {
+				v = pcg.Random()
+				bits = 64
+			}
+			buf[j] = byte(v)
+			v >>= 8
+			bits -= 8
+		}
+	}
+}
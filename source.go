@@ -0,0 +1,91 @@
+package pcg
+
+// PCG Random Number Generation
+// Developed by Melissa O'Neill <oneill@pcg-random.org>
+// Paper and details at http://www.pcg-random.org
+// Ported to Go by Michael Jones <michael.jones@gmail.com>
+
+import (
+	"math/rand"
+	randv2 "math/rand/v2"
+)
+
+// Uint64 returns the next 64-bit output, combining two successive 32-bit
+// draws the same way PCG64 combines two PCG32 streams.
+func (p *PCG32) Uint64() uint64 {
+	return uint64(p.Random())<<32 | uint64(p.Random())
+}
+
+// Int63 returns a non-negative 63-bit pseudo-random int64, as required by
+// math/rand.Source.
+func (p *PCG32) Int63() int64 {
+	return int64(p.Uint64() >> 1)
+}
+
+// Uint64 returns the next 64-bit output.
+func (p *PCG64) Uint64() uint64 {
+	return p.Random()
+}
+
+// Int63 returns a non-negative 63-bit pseudo-random int64, as required by
+// math/rand.Source.
+func (p *PCG64) Int63() int64 {
+	return int64(p.Uint64() >> 1)
+}
+
+// PCG32 and PCG64 already have a multi-word Seed (Seed(state, sequence) on
+// PCG32, Seed(state1, state2, sequence1, sequence2) on PCG64) used to pick
+// independent streams, so a single-argument Seed(int64) can't
+// live on those types without colliding with it. rand.Source requires
+// exactly that signature, so rand64Source and rand32Source adapt a *PCG64
+// or *PCG32 by routing the stdlib's 64-bit seed into the existing
+// multi-word Seed.
+type rand64Source struct {
+	p *PCG64
+}
+
+func (s *rand64Source) Uint64() uint64 { return s.p.Uint64() }
+func (s *rand64Source) Int63() int64   { return s.p.Int63() }
+func (s *rand64Source) Seed(seed int64) {
+	u := uint64(seed)
+	s.p.Seed(u, u, u, u)
+}
+
+// AsSource adapts p to rand.Source64, so it can be passed to rand.New and
+// used with the full set of math/rand distribution helpers (Intn, Float64,
+// Perm, Shuffle, and so on).
+func AsSource(p *PCG64) rand.Source64 {
+	return &rand64Source{p}
+}
+
+// AsV2Source adapts p to math/rand/v2.Source. Unlike rand.Source, the v2
+// Source interface is just Uint64() uint64, which PCG64 already implements
+// directly, so no wrapper type is needed.
+func AsV2Source(p *PCG64) randv2.Source {
+	return p
+}
+
+type rand32Source struct {
+	p *PCG32
+}
+
+func (s *rand32Source) Uint64() uint64 { return s.p.Uint64() }
+func (s *rand32Source) Int63() int64   { return s.p.Int63() }
+func (s *rand32Source) Seed(seed int64) {
+	u := uint64(seed)
+	s.p.Seed(u, u)
+}
+
+// AsSource32 adapts p to rand.Source64, so it can be passed to rand.New and
+// used with the full set of math/rand distribution helpers (Intn, Float64,
+// Perm, Shuffle, and so on).
+func AsSource32(p *PCG32) rand.Source64 {
+	return &rand32Source{p}
+}
+
+// AsV2Source32 adapts p to math/rand/v2.Source. Unlike rand.Source, the v2
+// Source interface is just Uint64() uint64, which PCG32 already implements
+// directly, so no wrapper type is needed.
+func AsV2Source32(p *PCG32) randv2.Source {
+	return p
+}
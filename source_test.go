@@ -0,0 +1,107 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import (
+	"math/rand"
+	randv2 "math/rand/v2"
+	"testing"
+)
+
+// Does a PCG64 wrapped with AsSource drive math/rand's distribution helpers?
+func TestAsSource(t *testing.T) {
+	r := rand.New(AsSource(NewPCG64().Seed(1, 1, 1, 2)))
+
+	for i := 0; i < 1000; i++ {
+		if n := r.Intn(100); n < 0 || n >= 100 {
+			t.Fatalf("Intn(100) returned %d, want [0, 100)", n)
+		}
+	}
+
+	perm := r.Perm(52)
+	seen := make(map[int]bool, len(perm))
+	for _, v := range perm {
+		if seen[v] {
+			t.Fatalf("Perm(52) repeated value %d", v)
+		}
+		seen[v] = true
+	}
+}
+
+// Does a PCG64 wrapped with AsV2Source drive math/rand/v2's helpers?
+func TestAsV2Source(t *testing.T) {
+	r := randv2.New(AsV2Source(NewPCG64().Seed(1, 1, 1, 2)))
+
+	for i := 0; i < 1000; i++ {
+		if n := r.IntN(100); n < 0 || n >= 100 {
+			t.Fatalf("IntN(100) returned %d, want [0, 100)", n)
+		}
+	}
+}
+
+// Do two generators seeded alike, one driven directly and one through the
+// Source64 adapter, produce the same stream?
+func TestAsSourceMatchesDirectDraws(t *testing.T) {
+	direct := NewPCG64().Seed(7, 7, 9, 10)
+	wrapped := AsSource(NewPCG64().Seed(7, 7, 9, 10))
+
+	for i := 0; i < 256; i++ {
+		if want, got := direct.Random(), wrapped.(rand.Source64).Uint64(); want != got {
+			t.Fatalf("#%d: direct = %d, wrapped = %d", i, want, got)
+		}
+	}
+}
+
+// Does a PCG32 wrapped with AsSource32 drive math/rand's distribution
+// helpers?
+func TestAsSource32(t *testing.T) {
+	r := rand.New(AsSource32(NewPCG32().Seed(1, 1)))
+
+	for i := 0; i < 1000; i++ {
+		if n := r.Intn(100); n < 0 || n >= 100 {
+			t.Fatalf("Intn(100) returned %d, want [0, 100)", n)
+		}
+	}
+
+	perm := r.Perm(52)
+	seen := make(map[int]bool, len(perm))
+	for _, v := range perm {
+		if seen[v] {
+			t.Fatalf("Perm(52) repeated value %d", v)
+		}
+		seen[v] = true
+	}
+}
+
+// Does a PCG32 wrapped with AsV2Source32 drive math/rand/v2's helpers?
+func TestAsV2Source32(t *testing.T) {
+	r := randv2.New(AsV2Source32(NewPCG32().Seed(1, 1)))
+
+	for i := 0; i < 1000; i++ {
+		if n := r.IntN(100); n < 0 || n >= 100 {
+			t.Fatalf("IntN(100) returned %d, want [0, 100)", n)
+		}
+	}
+}
+
+// Do two generators seeded alike, one driven directly and one through the
+// Source64 adapter, produce the same stream?
+func TestAsSource32MatchesDirectDraws(t *testing.T) {
+	direct := NewPCG32().Seed(7, 9)
+	wrapped := AsSource32(NewPCG32().Seed(7, 9))
+
+	for i := 0; i < 256; i++ {
+		if want, got := direct.Uint64(), wrapped.(rand.Source64).Uint64(); want != got {
+			t.Fatalf("#%d: direct = %d, wrapped = %d", i, want, got)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package pcg
+
+// PCG Random Number Generation
+// Developed by Melissa O'Neill <oneill@pcg-random.org>
+// Paper and details at http://www.pcg-random.org
+// Ported to Go by Michael Jones <michael.jones@gmail.com>
+
+// defaultSplitStride is the default spacing Split uses between the streams
+// it carves out of a single generator, chosen so that even a worker
+// drawing a few billion values has no realistic chance of overlapping its
+// neighbor.
+const defaultSplitStride = 1 << 48
+
+// Split returns n independent generators cloned from p, each advanced by a
+// multiple of stride so their streams cannot overlap within stride draws.
+// stride is optional; omitting it, or passing 0, uses defaultSplitStride.
+//
+// This carves non-overlapping ranges out of p's single stream, which is
+// the right tool for fork/join parallelism within one worker pool. It is
+// a different tool from Seed's distinct sequence constants: Seed picks one
+// of 2^63 independent streams up front (the common case for, say, giving
+// each simulation run its own generator), whereas Split partitions a
+// single stream after the fact (the common case for handing contiguous,
+// non-overlapping slices of one generator's output to a fixed set of
+// workers).
+func (p *PCG64) Split(n int, stride ...uint64) []*PCG64 {
+	if n <= 0 {
+		return nil
+	}
+	s := uint64(defaultSplitStride)
+	if len(stride) > 0 && stride[0] != 0 {
+		s = stride[0]
+	}
+
+	generators := make([]*PCG64, n)
+	for k := 0; k < n; k++ {
+		clone := &PCG64{
+			lo: &PCG32{state: p.lo.state, inc: p.lo.inc},
+			hi: &PCG32{state: p.hi.state, inc: p.hi.inc},
+		}
+		clone.Advance(uint64(k) * s)
+		generators[k] = clone
+	}
+	return generators
+}
+
+// Jump advances p by a fixed large distance, letting callers carve
+// non-overlapping streams out of p one Jump at a time without computing a
+// stride themselves, the same way xoroshiro/xoshiro generators use
+// Jump/LongJump. Unlike those generators, whose period is astronomically
+// larger than any realistic draw count, PCG64's state has only a 2^64
+// period, so jumping by a literal 2^64 would wrap all the way back to the
+// current position; Jump uses the same conservative defaultSplitStride
+// Split falls back to instead.
+func (p *PCG64) Jump() *PCG64 {
+	return p.Advance(defaultSplitStride)
+}
@@ -0,0 +1,92 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import "testing"
+
+// Does Split produce generators whose initial draws match Advance by the
+// same stride on a clone of the original?
+func TestSplitMatchesAdvance(t *testing.T) {
+	const stride = 1 << 20
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	generators := source.Split(4, stride)
+
+	for k, g := range generators {
+		want := NewPCG64().Seed(1, 1, 1, 2)
+		want.Advance(uint64(k) * stride)
+		if a, b := want.Random(), g.Random(); a != b {
+			t.Fatalf("generator %d: want %d, got %d", k, a, b)
+		}
+	}
+}
+
+// Does a stride of 0 fall back to defaultSplitStride?
+func TestSplitDefaultStride(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	generators := source.Split(2, 0)
+
+	want := NewPCG64().Seed(1, 1, 1, 2)
+	want.Advance(defaultSplitStride)
+	if a, b := want.Random(), generators[1].Random(); a != b {
+		t.Fatalf("generator 1: want %d, got %d", a, b)
+	}
+}
+
+// Does omitting stride entirely also fall back to defaultSplitStride?
+func TestSplitOmittedStride(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	generators := source.Split(2)
+
+	want := NewPCG64().Seed(1, 1, 1, 2)
+	want.Advance(defaultSplitStride)
+	if a, b := want.Random(), generators[1].Random(); a != b {
+		t.Fatalf("generator 1: want %d, got %d", a, b)
+	}
+}
+
+// Does splitting leave the original generator untouched?
+func TestSplitDoesNotMutateSource(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	expect := NewPCG64().Seed(1, 1, 1, 2).Random()
+
+	source.Split(8, 1<<20)
+
+	if got := NewPCG64().Seed(1, 1, 1, 2).Random(); got != expect {
+		t.Fatalf("sanity check itself is broken: %d != %d", got, expect)
+	}
+	if got := source.Random(); got != expect {
+		t.Fatalf("Split mutated its receiver: want %d, got %d", expect, got)
+	}
+}
+
+// Does a non-positive n return an empty result instead of panicking?
+func TestSplitNonPositiveN(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	if got := source.Split(0, 0); len(got) != 0 {
+		t.Errorf("Split(0, 0) = %v, want empty", got)
+	}
+	if got := source.Split(-1, 0); len(got) != 0 {
+		t.Errorf("Split(-1, 0) = %v, want empty", got)
+	}
+}
+
+// Does Jump advance the receiver by defaultSplitStride?
+func TestJump(t *testing.T) {
+	source := NewPCG64().Seed(1, 1, 1, 2)
+	want := NewPCG64().Seed(1, 1, 1, 2)
+	want.Advance(defaultSplitStride)
+
+	source.Jump()
+	if a, b := want.Random(), source.Random(); a != b {
+		t.Fatalf("want %d, got %d", a, b)
+	}
+}
@@ -0,0 +1,60 @@
+package pcg
+
+// Copyright 2018 Michael T. Jones
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the License for
+// the specific language governing permissions and limitations under the License.
+
+import "testing"
+
+// Does NewZipf reject invalid parameters?
+func TestNewZipfRejectsInvalidParameters(t *testing.T) {
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	if z := NewZipf(pcg, 1.0, 1.0, 100); z != nil {
+		t.Errorf("NewZipf with s = 1.0 should return nil")
+	}
+	if z := NewZipf(pcg, 2.0, 0.5, 100); z != nil {
+		t.Errorf("NewZipf with v < 1 should return nil")
+	}
+}
+
+// Does every draw land in [0, imax]?
+func TestZipfBounds(t *testing.T) {
+	pcg := NewPCG64().Seed(1, 1, 1, 2)
+	const imax = 100
+	z := NewZipf(pcg, 2.0, 1.0, imax)
+
+	for i := 0; i < 10000; i++ {
+		if k := z.Uint64(); k > imax {
+			t.Fatalf("#%d: Uint64() = %d; want <= %d", i, k, imax)
+		}
+	}
+}
+
+// Does Zipf reproduce the same stream for the same seed?
+func TestZipfReproducible(t *testing.T) {
+	a := NewZipf(NewPCG64().Seed(1, 1, 1, 2), 2.0, 1.0, 1000)
+	b := NewZipf(NewPCG64().Seed(1, 1, 1, 2), 2.0, 1.0, 1000)
+	for i := 0; i < 1000; i++ {
+		if x, y := a.Uint64(), b.Uint64(); x != y {
+			t.Fatalf("#%d: %d != %d", i, x, y)
+		}
+	}
+}
+
+// Measure the time it takes to draw from a Zipf distribution
+func BenchmarkZipfUint64(b *testing.B) {
+	b.StopTimer()
+	z := NewZipf(NewPCG64().Seed(1, 1, 1, 2), 2.0, 1.0, 1000)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = z.Uint64()
+	}
+}